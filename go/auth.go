@@ -0,0 +1,103 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// defaultAuthCacheTTL is how long a header value produced by an Authenticator
+// is reused before Authenticate is called again.
+const defaultAuthCacheTTL = 60 * time.Second
+
+// Authenticator produces the value of the outbound auth header sent with
+// every request to the Super App. The default implementation wraps a static
+// API key, but callers can plug in their own OAuth2, JWT, or mTLS-backed
+// token provider.
+type Authenticator interface {
+	// Authenticate returns the full header value to send, e.g. "Bearer abc123".
+	Authenticate(ctx context.Context) (string, error)
+}
+
+// apiKeyAuthenticator is the default Authenticator: it wraps SuperAppSDK's
+// static API key in a Bearer-scheme header value.
+type apiKeyAuthenticator struct {
+	apiKey string
+}
+
+func (a *apiKeyAuthenticator) Authenticate(ctx context.Context) (string, error) {
+	if a.apiKey == "" {
+		return "", fmt.Errorf("sdk: no API key configured")
+	}
+	return "Bearer " + a.apiKey, nil
+}
+
+// authCache remembers the last header value handed out by an Authenticator
+// so short-lived tokens aren't re-validated on every call, similar to the
+// Super App's authenticateWithBackend cached-auth pattern. It holds a single
+// slot since a SuperAppSDK has exactly one Authenticator at a time.
+type authCache struct {
+	mu     sync.Mutex
+	header string
+	expiry time.Time
+	ttl    time.Duration
+}
+
+func newAuthCache(ttl time.Duration) *authCache {
+	return &authCache{ttl: ttl}
+}
+
+// get returns the cached header value, if any, and whether it's still valid.
+func (c *authCache) get() (string, bool) {
+	if c.ttl <= 0 {
+		return "", false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.header == "" || time.Now().After(c.expiry) {
+		return "", false
+	}
+	return c.header, true
+}
+
+func (c *authCache) remember(header string) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.header = header
+	c.expiry = time.Now().Add(c.ttl)
+}
+
+// invalidate drops header from the cache, forcing the next authHeaderValue
+// call to re-authenticate. A no-op if header is no longer the cached value
+// (e.g. a concurrent call already refreshed it).
+func (c *authCache) invalidate(header string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.header == header {
+		c.header = ""
+	}
+}
+
+// authHeaderValue returns the header value to attach to an outbound request,
+// consulting the cache before calling through to the configured Authenticator.
+func (sdk *SuperAppSDK) authHeaderValue(ctx context.Context) (string, error) {
+	if header, ok := sdk.authCache.get(); ok {
+		return header, nil
+	}
+	header, err := sdk.Authenticator.Authenticate(ctx)
+	if err != nil {
+		return "", fmt.Errorf("sdk: authentication failed: %w", err)
+	}
+	sdk.authCache.remember(header)
+	return header, nil
+}
+
+// invalidateAuth drops a cached header value, forcing the next call to
+// re-authenticate. Called whenever the Super App responds with 401.
+func (sdk *SuperAppSDK) invalidateAuth(header string) {
+	sdk.authCache.invalidate(header)
+}
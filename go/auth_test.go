@@ -0,0 +1,114 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// countingAuthenticator returns a fixed header value and records how many
+// times Authenticate was called, so tests can assert on cache behavior.
+type countingAuthenticator struct {
+	calls  int
+	header string
+}
+
+func (a *countingAuthenticator) Authenticate(ctx context.Context) (string, error) {
+	a.calls++
+	return a.header, nil
+}
+
+func newTestSDK(baseURL string, auth Authenticator) *SuperAppSDK {
+	sdk := newSDK("unused", baseURL)
+	sdk.Authenticator = auth
+	return sdk
+}
+
+func TestCallFunctionSendsAuthHeader(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"ok": true})
+	}))
+	defer server.Close()
+
+	sdk := newTestSDK(server.URL, &countingAuthenticator{header: "Bearer abc123"})
+	if _, err := sdk.CallFunction("caller", "target", "fn", nil); err != nil {
+		t.Fatalf("CallFunction failed: %v", err)
+	}
+	if gotAuth != "Bearer abc123" {
+		t.Errorf("Authorization header = %q, want %q", gotAuth, "Bearer abc123")
+	}
+}
+
+func TestAuthHeaderValueIsCached(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"ok": true})
+	}))
+	defer server.Close()
+
+	auth := &countingAuthenticator{header: "Bearer abc123"}
+	sdk := newTestSDK(server.URL, auth)
+
+	if _, err := sdk.CallFunction("caller", "target", "fn", nil); err != nil {
+		t.Fatalf("first CallFunction failed: %v", err)
+	}
+	if _, err := sdk.CallFunction("caller", "target", "fn", nil); err != nil {
+		t.Fatalf("second CallFunction failed: %v", err)
+	}
+	if auth.calls != 1 {
+		t.Errorf("Authenticate called %d times, want 1 (cached)", auth.calls)
+	}
+}
+
+func TestCallFunctionOnStructLiteralSDK(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"ok": true})
+	}))
+	defer server.Close()
+
+	sdk := &SuperAppSDK{APIKey: "abc123", BaseURL: server.URL}
+	if _, err := sdk.CallFunction("caller", "target", "fn", nil); err != nil {
+		t.Fatalf("CallFunction on struct-literal SDK failed: %v", err)
+	}
+	if gotAuth != "Bearer abc123" {
+		t.Errorf("Authorization header = %q, want %q", gotAuth, "Bearer abc123")
+	}
+}
+
+func TestCallFunction401InvalidatesCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"ok": true})
+	}))
+	defer server.Close()
+
+	auth := &countingAuthenticator{header: "Bearer abc123"}
+	sdk := newTestSDK(server.URL, auth)
+
+	_, err := sdk.CallFunction("caller", "target", "fn", nil)
+	httpErr, ok := err.(*HTTPRequestError)
+	if !ok || httpErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 HTTPRequestError, got %v", err)
+	}
+
+	if _, err := sdk.CallFunction("caller", "target", "fn", nil); err != nil {
+		t.Fatalf("second CallFunction failed: %v", err)
+	}
+	if auth.calls != 2 {
+		t.Errorf("Authenticate called %d times, want 2 (re-auth after 401)", auth.calls)
+	}
+}
@@ -0,0 +1,49 @@
+package sdk
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"strings"
+)
+
+// dumpRequest logs a verbose dump of an outbound request when sdk.Debug is
+// enabled, redacting the auth header and skipping multipart bodies (which
+// are streamed, so dumping would buffer the whole body in memory).
+func (sdk *SuperAppSDK) dumpRequest(req *http.Request) {
+	if !sdk.Debug {
+		return
+	}
+	includeBody := !strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/")
+	dump, err := httputil.DumpRequestOut(req, includeBody)
+	if err != nil {
+		sdk.Logger.Warn("failed to dump request", "error", err)
+		return
+	}
+	sdk.Logger.Debug("outbound request", "dump", redactHeader(string(dump), sdk.AuthHeader))
+}
+
+// dumpResponse logs a verbose dump of an inbound response when sdk.Debug is
+// enabled.
+func (sdk *SuperAppSDK) dumpResponse(resp *http.Response) {
+	if !sdk.Debug {
+		return
+	}
+	dump, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		sdk.Logger.Warn("failed to dump response", "error", err)
+		return
+	}
+	sdk.Logger.Debug("inbound response", "dump", string(dump))
+}
+
+// redactHeader blanks out the value of header in a raw HTTP dump.
+func redactHeader(dump, header string) string {
+	lines := strings.Split(dump, "\r\n")
+	prefix := strings.ToLower(header) + ":"
+	for i, line := range lines {
+		if strings.HasPrefix(strings.ToLower(line), prefix) {
+			lines[i] = header + ": [REDACTED]"
+		}
+	}
+	return strings.Join(lines, "\r\n")
+}
@@ -0,0 +1,26 @@
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// HTTPRequestError is returned for any non-2xx response from the Super App,
+// so callers can branch on StatusCode programmatically instead of matching
+// against an error string.
+type HTTPRequestError struct {
+	StatusCode int
+	Body       string
+	Method     string
+	URL        string
+}
+
+func (e *HTTPRequestError) Error() string {
+	return fmt.Sprintf("%s %s: server returned status %d: %s", e.Method, e.URL, e.StatusCode, e.Body)
+}
+
+// Temporary reports whether the error represents a condition worth retrying
+// (429 or any 5xx).
+func (e *HTTPRequestError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
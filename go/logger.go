@@ -0,0 +1,72 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+	"strings"
+)
+
+// Logger receives the SDK's structured log output. Implementations must be
+// safe for concurrent use. kv is a flat list of alternating key/value pairs,
+// mirroring slog's convention.
+type Logger interface {
+	Debug(msg string, kv ...any)
+	Info(msg string, kv ...any)
+	Warn(msg string, kv ...any)
+	Error(msg string, kv ...any)
+}
+
+// defaultLogger is the stdlib-backed Logger used when no Logger is
+// configured, writing level-prefixed lines via the standard log package.
+type defaultLogger struct {
+	l *log.Logger
+}
+
+// NewDefaultLogger wraps a standard library *log.Logger as a Logger. A nil
+// l falls back to log.Default().
+func NewDefaultLogger(l *log.Logger) Logger {
+	if l == nil {
+		l = log.Default()
+	}
+	return &defaultLogger{l: l}
+}
+
+func (d *defaultLogger) Debug(msg string, kv ...any) { d.log("DEBUG", msg, kv...) }
+func (d *defaultLogger) Info(msg string, kv ...any)  { d.log("INFO", msg, kv...) }
+func (d *defaultLogger) Warn(msg string, kv ...any)  { d.log("WARN", msg, kv...) }
+func (d *defaultLogger) Error(msg string, kv ...any) { d.log("ERROR", msg, kv...) }
+
+func (d *defaultLogger) log(level, msg string, kv ...any) {
+	d.l.Printf("[%s] %s%s", level, msg, formatKV(kv))
+}
+
+func formatKV(kv []any) string {
+	if len(kv) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	for i := 0; i+1 < len(kv); i += 2 {
+		fmt.Fprintf(&b, " %v=%v", kv[i], kv[i+1])
+	}
+	return b.String()
+}
+
+// SlogLogger adapts a *slog.Logger to the Logger interface.
+type SlogLogger struct {
+	L *slog.Logger
+}
+
+// NewSlogLogger wraps a *slog.Logger as a Logger. A nil l falls back to
+// slog.Default().
+func NewSlogLogger(l *slog.Logger) Logger {
+	if l == nil {
+		l = slog.Default()
+	}
+	return &SlogLogger{L: l}
+}
+
+func (s *SlogLogger) Debug(msg string, kv ...any) { s.L.Debug(msg, kv...) }
+func (s *SlogLogger) Info(msg string, kv ...any)  { s.L.Info(msg, kv...) }
+func (s *SlogLogger) Warn(msg string, kv ...any)  { s.L.Warn(msg, kv...) }
+func (s *SlogLogger) Error(msg string, kv ...any) { s.L.Error(msg, kv...) }
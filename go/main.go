@@ -2,86 +2,208 @@ package sdk
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"log"
 	"net/http"
+	"sync"
 	"time"
 )
 
 type SuperAppSDK struct {
 	APIKey  string
 	BaseURL string
+
+	// Authenticator produces the outbound auth header for every request.
+	// Defaults to a Bearer token built from APIKey; override it to plug in
+	// OAuth2, JWT, or mTLS-backed token providers.
+	Authenticator Authenticator
+	// AuthHeader is the HTTP header carrying the credential returned by
+	// Authenticator. Defaults to "Authorization".
+	AuthHeader string
+	// RetryPolicy controls retries for outbound HTTP calls. NewSuperAppSDK
+	// sets it to DefaultRetryPolicy(); the zero value left by a struct
+	// literal makes a single attempt with no retries.
+	RetryPolicy RetryPolicy
+	// HTTPClient is used to probe candidate URLs and make outbound calls.
+	// Defaults to a client with a 10s timeout.
+	HTTPClient *http.Client
+	// Logger receives the SDK's structured log output. Defaults to a
+	// stdlib-backed Logger.
+	Logger Logger
+	// Debug enables verbose request/response dumping (with the auth header
+	// redacted and multipart bodies skipped) through Logger.Debug.
+	Debug bool
+
+	resolver  Resolver
+	authCache *authCache
+	once      sync.Once
 }
 
-func NewSuperAppSDK(apiKey string) *SuperAppSDK {
-	// Try multiple possible URLs based on different network setups
-	urls := []string{
-		"http://localhost:8080/v1/super",
-		"http://host.docker.internal:8080/v1/super",
-	}
-	// Test each URL
-	for _, url := range urls {
-		client := &http.Client{
-			Timeout: 1 * time.Second,
+// ensureDefaults lazily fills in any nil fields left by constructing a
+// SuperAppSDK directly as a struct literal instead of through
+// NewSuperAppSDK, so the zero value of every exported field stays safe to
+// use. Called at the top of every exported entry point.
+func (sdk *SuperAppSDK) ensureDefaults() {
+	sdk.once.Do(func() {
+		if sdk.Authenticator == nil {
+			sdk.Authenticator = &apiKeyAuthenticator{apiKey: sdk.APIKey}
+		}
+		if sdk.AuthHeader == "" {
+			sdk.AuthHeader = "Authorization"
+		}
+		if sdk.HTTPClient == nil {
+			sdk.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+		}
+		if sdk.Logger == nil {
+			sdk.Logger = NewDefaultLogger(nil)
 		}
-		_, err := client.Get(url + "/list")
-		if err == nil {
-			log.Printf("✅ Successfully connected to Super App at %s\n", url)
-			return &SuperAppSDK{
-				APIKey:  apiKey,
-				BaseURL: url,
-			}
+		if sdk.authCache == nil {
+			sdk.authCache = newAuthCache(defaultAuthCacheTTL)
 		}
-		log.Printf("❌ Could not connect to %s: %v\n", url, err)
+	})
+}
+
+// NewSuperAppSDK discovers the Super App's base URL and returns a ready to
+// use SDK. By default it probes localhost and host.docker.internal; pass
+// WithResolver to discover candidates another way (env var, DNS SRV, a
+// manifest file), which is required in environments like Kubernetes/Nomad
+// where those defaults aren't valid.
+func NewSuperAppSDK(apiKey string, opts ...Option) *SuperAppSDK {
+	sdk := newSDK(apiKey, "http://localhost:8080/v1/super")
+	sdk.resolver = defaultResolver()
+	for _, opt := range opts {
+		opt(sdk)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	urls, err := sdk.resolver.Resolve(ctx)
+	if err != nil || len(urls) == 0 {
+		sdk.Logger.Warn("could not resolve Super App URL, using default", "error", err, "url", sdk.BaseURL)
+		return sdk
 	}
-	// Default to localhost if none of the URLs worked
-	log.Println("⚠️ Using default Super App URL, but connection not verified")
+
+	if url, ok := probeURLs(ctx, sdk.HTTPClient, urls); ok {
+		sdk.Logger.Info("connected to Super App", "url", url)
+		sdk.BaseURL = url
+		return sdk
+	}
+
+	sdk.Logger.Warn("using default Super App URL, but connection not verified", "url", sdk.BaseURL)
+	return sdk
+}
+
+func newSDK(apiKey, baseURL string) *SuperAppSDK {
 	return &SuperAppSDK{
-		APIKey:  apiKey,
-		BaseURL: "http://localhost:8080/v1/super",
+		APIKey:        apiKey,
+		BaseURL:       baseURL,
+		Authenticator: &apiKeyAuthenticator{apiKey: apiKey},
+		AuthHeader:    "Authorization",
+		RetryPolicy:   DefaultRetryPolicy(),
+		HTTPClient:    &http.Client{Timeout: 10 * time.Second},
+		Logger:        NewDefaultLogger(nil),
+		authCache:     newAuthCache(defaultAuthCacheTTL),
 	}
 }
 
-// Register Mini-App with retry logic and URL for function invocation
+// FunctionSpec describes a single function advertised during registration.
+// ContentType lets the Super App know which functions accept multipart
+// payloads (e.g. via CallFunctionMultipart) instead of plain JSON.
+type FunctionSpec struct {
+	Name string
+	// ContentType defaults to "application/json" when empty.
+	ContentType string
+}
+
+// Register Mini-App with retry logic and URL for function invocation.
+// It is a thin wrapper around RegisterContext using context.Background().
 func (sdk *SuperAppSDK) Register(appName string, functions []string, appURL string) error {
+	return sdk.RegisterContext(context.Background(), appName, functions, appURL)
+}
+
+// RegisterContext is Register with an explicit context, so callers can
+// cancel registration or bound it to a parent request deadline.
+func (sdk *SuperAppSDK) RegisterContext(ctx context.Context, appName string, functions []string, appURL string) error {
+	specs := make([]FunctionSpec, len(functions))
+	for i, name := range functions {
+		specs[i] = FunctionSpec{Name: name}
+	}
+	return sdk.RegisterFunctionsContext(ctx, appName, specs, appURL)
+}
+
+// RegisterFunctions is Register with a per-function content-type
+// declaration, so the Super App knows which functions accept multipart
+// payloads. It is a thin wrapper around RegisterFunctionsContext.
+func (sdk *SuperAppSDK) RegisterFunctions(appName string, functions []FunctionSpec, appURL string) error {
+	return sdk.RegisterFunctionsContext(context.Background(), appName, functions, appURL)
+}
+
+// RegisterFunctionsContext is RegisterFunctions with an explicit context.
+func (sdk *SuperAppSDK) RegisterFunctionsContext(ctx context.Context, appName string, functions []FunctionSpec, appURL string) error {
+	sdk.ensureDefaults()
+
 	// Make sure the URL doesn't end with a slash
 	if len(appURL) > 0 && appURL[len(appURL)-1] == '/' {
 		appURL = appURL[:len(appURL)-1]
 	}
 
+	functionDescs := make([]map[string]string, len(functions))
+	for i, f := range functions {
+		contentType := f.ContentType
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		functionDescs[i] = map[string]string{"name": f.Name, "contentType": contentType}
+	}
+
 	// Implementing the TODO: Send my URL to the Super App
 	payload, _ := json.Marshal(map[string]any{
 		"appName":   appName,
-		"functions": functions,
+		"functions": functionDescs,
 		"url":       appURL, // Adding the base URL where this mini-app can be reached
 	})
 
-	// Try a few times in case the server is still starting up
-	var lastErr error
-	for i := 0; i < 3; i++ {
-		resp, err := http.Post(sdk.BaseURL+"/register", "application/json", bytes.NewBuffer(payload))
+	authHeader, err := sdk.authHeaderValue(ctx)
+	if err != nil {
+		return err
+	}
+
+	requestID := newRequestID()
+	sdk.Logger.Info("registering mini-app", "app_name", appName, "request_id", requestID)
+
+	// Register is idempotent, so it's safe to retry per sdk.RetryPolicy.
+	body, err := sdk.doRequest(ctx, sdk.HTTPClient, true, func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", sdk.BaseURL+"/register", bytes.NewBuffer(payload))
 		if err != nil {
-			log.Printf("❌ Register attempt %d failed: %v\n", i+1, err)
-			lastErr = err
-			time.Sleep(1 * time.Second)
-			continue
-		}
-		defer resp.Body.Close()
-		body, _ := ioutil.ReadAll(resp.Body)
-		log.Printf("Register response (attempt %d): %s\n", i+1, string(body))
-		if resp.StatusCode == http.StatusOK {
-			return nil
+			return nil, err
 		}
-		lastErr = fmt.Errorf("server returned non-OK status: %d - %s", resp.StatusCode, string(body))
-		time.Sleep(1 * time.Second)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(sdk.AuthHeader, authHeader)
+		req.Header.Set("X-Request-ID", requestID)
+		return req, nil
+	})
+	sdk.Logger.Info("register response", "request_id", requestID, "body", string(body))
+	if httpErr, ok := err.(*HTTPRequestError); ok && httpErr.StatusCode == http.StatusUnauthorized {
+		sdk.invalidateAuth(authHeader)
 	}
-	return lastErr
+	return err
 }
 
-// Call Another Mini-App's Function with better error reporting
+// CallFunction calls another Mini-App's function with better error
+// reporting. It is a thin wrapper around CallFunctionContext using
+// context.Background().
 func (sdk *SuperAppSDK) CallFunction(caller, targetApp, functionName string, payload map[string]interface{}) (map[string]interface{}, error) {
+	return sdk.CallFunctionContext(context.Background(), caller, targetApp, functionName, payload)
+}
+
+// CallFunctionContext is CallFunction with an explicit context, so callers
+// can cancel a slow call or bound it to a parent request deadline.
+// Cancellation aborts pending retry backoff sleeps immediately.
+func (sdk *SuperAppSDK) CallFunctionContext(ctx context.Context, caller, targetApp, functionName string, payload map[string]interface{}) (map[string]interface{}, error) {
+	sdk.ensureDefaults()
+
 	requestBody, err := json.Marshal(map[string]any{
 		"caller":       caller,
 		"targetApp":    targetApp,
@@ -91,28 +213,36 @@ func (sdk *SuperAppSDK) CallFunction(caller, targetApp, functionName string, pay
 	if err != nil {
 		return nil, fmt.Errorf("error encoding request JSON: %v", err)
 	}
-	log.Printf("Calling %s.%s with payload: %s\n", targetApp, functionName, string(requestBody))
 
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-	req, err := http.NewRequest("POST", sdk.BaseURL+"/call-function", bytes.NewBuffer(requestBody))
+	requestID := newRequestID()
+	sdk.Logger.Info("calling function", "target_app", targetApp, "function", functionName, "request_id", requestID)
+
+	authHeader, err := sdk.authHeaderValue(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+		return nil, err
 	}
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error calling function: %v", err)
+
+	// CallFunction invokes arbitrary mini-app functions, which may not be
+	// idempotent, so it is not retried automatically.
+	body, err := sdk.doRequest(ctx, sdk.HTTPClient, false, func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", sdk.BaseURL+"/call-function", bytes.NewBuffer(requestBody))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(sdk.AuthHeader, authHeader)
+		req.Header.Set("X-Request-ID", requestID)
+		return req, nil
+	})
+	sdk.Logger.Debug("call-function response", "request_id", requestID, "body", string(body))
+	if httpErr, ok := err.(*HTTPRequestError); ok {
+		if httpErr.StatusCode == http.StatusUnauthorized {
+			sdk.invalidateAuth(authHeader)
+		}
+		return nil, httpErr
 	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %v", err)
-	}
-	log.Printf("Raw response from call-function: %s\n", string(body))
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("server returned non-OK status: %d - %s", resp.StatusCode, string(body))
+		return nil, err
 	}
 	var result map[string]interface{}
 	if err := json.Unmarshal(body, &result); err != nil {
@@ -0,0 +1,149 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// CallFunctionMultipart invokes a target function with both form fields and
+// file content, streaming the request body as multipart/form-data instead
+// of buffering everything into a single JSON payload. Use this for file
+// uploads or other large payloads.
+func (sdk *SuperAppSDK) CallFunctionMultipart(caller, targetApp, function string, fields map[string]string, files map[string]io.Reader) (map[string]any, error) {
+	return sdk.CallFunctionMultipartContext(context.Background(), caller, targetApp, function, fields, files)
+}
+
+// CallFunctionMultipartContext is CallFunctionMultipart with an explicit
+// context for cancellation.
+func (sdk *SuperAppSDK) CallFunctionMultipartContext(ctx context.Context, caller, targetApp, function string, fields map[string]string, files map[string]io.Reader) (map[string]any, error) {
+	sdk.ensureDefaults()
+
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	go func() {
+		defer pw.Close()
+		defer writer.Close()
+
+		for _, meta := range [][2]string{
+			{"caller", caller},
+			{"targetApp", targetApp},
+			{"functionName", function},
+		} {
+			if err := writer.WriteField(meta[0], meta[1]); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		for name, value := range fields {
+			if err := writer.WriteField(name, value); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		for name, r := range files {
+			part, err := writer.CreateFormFile(name, name)
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			if _, err := io.Copy(part, r); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+	}()
+
+	authHeader, err := sdk.authHeaderValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestID := newRequestID()
+	sdk.Logger.Info("calling function (multipart)", "target_app", targetApp, "function", function, "request_id", requestID)
+
+	// Multipart bodies are single-use (the writer goroutine runs once), so
+	// this call is never retried regardless of sdk.RetryPolicy.
+	body, err := sdk.doRequest(ctx, sdk.HTTPClient, false, func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", sdk.BaseURL+"/call-function", pr)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.Header.Set(sdk.AuthHeader, authHeader)
+		req.Header.Set("X-Request-ID", requestID)
+		return req, nil
+	})
+	sdk.Logger.Debug("call-function (multipart) response", "request_id", requestID, "body", string(body))
+	if httpErr, ok := err.(*HTTPRequestError); ok && httpErr.StatusCode == http.StatusUnauthorized {
+		sdk.invalidateAuth(authHeader)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("error decoding response JSON: %v", err)
+	}
+	return result, nil
+}
+
+// CallFunctionStream invokes a target function and returns the raw response
+// body unread, letting callers consume a chunked/streaming response (e.g.
+// large files or long-running output) without buffering it in memory. The
+// caller must close the returned ReadCloser.
+func (sdk *SuperAppSDK) CallFunctionStream(caller, targetApp, function string, payload map[string]any) (io.ReadCloser, error) {
+	return sdk.CallFunctionStreamContext(context.Background(), caller, targetApp, function, payload)
+}
+
+// CallFunctionStreamContext is CallFunctionStream with an explicit context
+// for cancellation.
+func (sdk *SuperAppSDK) CallFunctionStreamContext(ctx context.Context, caller, targetApp, function string, payload map[string]any) (io.ReadCloser, error) {
+	sdk.ensureDefaults()
+
+	requestBody, err := json.Marshal(map[string]any{
+		"caller":       caller,
+		"targetApp":    targetApp,
+		"functionName": function,
+		"payload":      payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error encoding request JSON: %v", err)
+	}
+
+	authHeader, err := sdk.authHeaderValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestID := newRequestID()
+	sdk.Logger.Info("calling function (stream)", "target_app", targetApp, "function", function, "request_id", requestID)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", sdk.BaseURL+"/call-function-stream", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(sdk.AuthHeader, authHeader)
+	req.Header.Set("X-Request-ID", requestID)
+
+	resp, err := sdk.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling function: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode == http.StatusUnauthorized {
+			sdk.invalidateAuth(authHeader)
+		}
+		return nil, &HTTPRequestError{StatusCode: resp.StatusCode, Body: string(body), Method: req.Method, URL: req.URL.String()}
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,37 @@
+package sdk
+
+import (
+	"net/http"
+)
+
+// Option configures a SuperAppSDK during construction.
+type Option func(*SuperAppSDK)
+
+// WithResolver overrides how NewSuperAppSDK discovers candidate Super App
+// URLs. Defaults to probing localhost and host.docker.internal.
+func WithResolver(resolver Resolver) Option {
+	return func(sdk *SuperAppSDK) { sdk.resolver = resolver }
+}
+
+// WithHTTPClient overrides the HTTP client used to probe candidate URLs and
+// make outbound calls.
+func WithHTTPClient(client *http.Client) Option {
+	return func(sdk *SuperAppSDK) { sdk.HTTPClient = client }
+}
+
+// WithLogger overrides where the SDK sends its structured log output.
+// Defaults to a stdlib-backed Logger.
+func WithLogger(logger Logger) Option {
+	return func(sdk *SuperAppSDK) { sdk.Logger = logger }
+}
+
+// WithRetryPolicy overrides the default retry policy for outbound calls.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(sdk *SuperAppSDK) { sdk.RetryPolicy = policy }
+}
+
+// WithDebug enables verbose request/response dumping via Logger.Debug, with
+// the auth header redacted and multipart bodies skipped.
+func WithDebug(debug bool) Option {
+	return func(sdk *SuperAppSDK) { sdk.Debug = debug }
+}
@@ -0,0 +1,17 @@
+package sdk
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// newRequestID generates a random identifier for correlating a single
+// CallFunction invocation across distributed traces. It is sent as the
+// X-Request-ID header.
+func newRequestID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,135 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Resolver discovers candidate Super App base URLs. NewSuperAppSDK probes
+// every URL Resolve returns and keeps the first one that answers, which
+// lets the SDK run anywhere discovery works differently, e.g. Kubernetes or
+// Nomad, where localhost/docker-host aren't valid.
+type Resolver interface {
+	Resolve(ctx context.Context) ([]string, error)
+}
+
+// StaticResolver always resolves to a fixed list of URLs.
+type StaticResolver struct {
+	URLs []string
+}
+
+func (r StaticResolver) Resolve(ctx context.Context) ([]string, error) {
+	return r.URLs, nil
+}
+
+// EnvResolver resolves to the URL in an environment variable, SUPERAPP_URL
+// by default.
+type EnvResolver struct {
+	// Var is the environment variable to read. Defaults to "SUPERAPP_URL".
+	Var string
+}
+
+func (r EnvResolver) Resolve(ctx context.Context) ([]string, error) {
+	name := r.Var
+	if name == "" {
+		name = "SUPERAPP_URL"
+	}
+	url := os.Getenv(name)
+	if url == "" {
+		return nil, fmt.Errorf("sdk: environment variable %s is not set", name)
+	}
+	return []string{url}, nil
+}
+
+// DNSResolver discovers Super App instances via DNS SRV records, the
+// standard discovery mechanism in Kubernetes/Nomad deployments.
+type DNSResolver struct {
+	// Service, Proto, and Name are the SRV lookup components, e.g.
+	// Service="http", Proto="tcp", Name="superapp.default.svc.cluster.local".
+	Service string
+	Proto   string
+	Name    string
+	// Scheme prefixes each resolved host:port. Defaults to "http".
+	Scheme string
+}
+
+func (r DNSResolver) Resolve(ctx context.Context) ([]string, error) {
+	_, srvs, err := net.DefaultResolver.LookupSRV(ctx, r.Service, r.Proto, r.Name)
+	if err != nil {
+		return nil, fmt.Errorf("sdk: SRV lookup failed: %w", err)
+	}
+	scheme := r.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	urls := make([]string, 0, len(srvs))
+	for _, srv := range srvs {
+		urls = append(urls, fmt.Sprintf("%s://%s:%d", scheme, strings.TrimSuffix(srv.Target, "."), srv.Port))
+	}
+	return urls, nil
+}
+
+// FileResolver reads a JSON manifest of candidate URLs, e.g.
+// {"urls": ["http://superapp:8080/v1/super"]}.
+type FileResolver struct {
+	Path string
+}
+
+func (r FileResolver) Resolve(ctx context.Context) ([]string, error) {
+	data, err := os.ReadFile(r.Path)
+	if err != nil {
+		return nil, fmt.Errorf("sdk: reading resolver manifest: %w", err)
+	}
+	var manifest struct {
+		URLs []string `json:"urls"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("sdk: parsing resolver manifest: %w", err)
+	}
+	return manifest.URLs, nil
+}
+
+// defaultResolver reproduces the SDK's original hard-coded probe targets.
+func defaultResolver() Resolver {
+	return StaticResolver{URLs: []string{
+		"http://localhost:8080/v1/super",
+		"http://host.docker.internal:8080/v1/super",
+	}}
+}
+
+// probeURLs concurrently checks each candidate URL's /list endpoint and
+// returns the first one that answers before ctx is done.
+func probeURLs(ctx context.Context, client *http.Client, urls []string) (string, bool) {
+	type result struct {
+		url string
+		ok  bool
+	}
+	results := make(chan result, len(urls))
+	for _, url := range urls {
+		go func(url string) {
+			req, err := http.NewRequestWithContext(ctx, "GET", url+"/list", nil)
+			if err != nil {
+				results <- result{url, false}
+				return
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				results <- result{url, false}
+				return
+			}
+			resp.Body.Close()
+			results <- result{url, true}
+		}(url)
+	}
+	for i := 0; i < len(urls); i++ {
+		if r := <-results; r.ok {
+			return r.url, true
+		}
+	}
+	return "", false
+}
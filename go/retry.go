@@ -0,0 +1,147 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how SuperAppSDK retries outbound HTTP calls.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of tries, including the first.
+	// Values <= 1 disable retrying.
+	MaxAttempts int
+	// InitialBackoff is the sleep before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the computed sleep between retries.
+	MaxBackoff time.Duration
+	// Multiplier grows the backoff on each successive retry.
+	Multiplier float64
+	// Jitter adds up to this much random delay on top of the computed backoff.
+	Jitter time.Duration
+	// RetryableStatuses lists HTTP status codes worth retrying, in addition
+	// to network errors.
+	RetryableStatuses []int
+}
+
+// DefaultRetryPolicy keeps the SDK's previous three attempts, sleeping
+// roughly 1s and then 2s (plus up to 250ms jitter) between them, and retries
+// 429, 500, 502, 503 and 504 responses.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:       3,
+		InitialBackoff:    1 * time.Second,
+		MaxBackoff:        10 * time.Second,
+		Multiplier:        2,
+		Jitter:            250 * time.Millisecond,
+		RetryableStatuses: []int{http.StatusTooManyRequests, 500, 502, 503, 504},
+	}
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt))
+	if max := float64(p.MaxBackoff); max > 0 && d > max {
+		d = max
+	}
+	if p.Jitter > 0 {
+		d += float64(rand.Int63n(int64(p.Jitter)))
+	}
+	return time.Duration(d)
+}
+
+func (p RetryPolicy) isRetryableStatus(status int) bool {
+	for _, s := range p.RetryableStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRetryAfter reads the standard Retry-After header (seconds form).
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	secs, err := strconv.Atoi(v)
+	if err != nil || secs < 0 {
+		return 0, false
+	}
+	return time.Duration(secs) * time.Second, true
+}
+
+// doRequest executes an HTTP request built by buildReq, retrying per
+// sdk.RetryPolicy when idempotent is true and the failure is a network
+// error or a status listed in RetryPolicy.RetryableStatuses (honoring
+// Retry-After when present). Any non-2xx
+// response is surfaced as a typed *HTTPRequestError. Pending backoff sleeps
+// abort immediately if ctx is canceled.
+func (sdk *SuperAppSDK) doRequest(ctx context.Context, client *http.Client, idempotent bool, buildReq func() (*http.Request, error)) ([]byte, error) {
+	policy := sdk.RetryPolicy
+	attempts := policy.MaxAttempts
+	if !idempotent || attempts < 1 {
+		attempts = 1
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		req, err := buildReq()
+		if err != nil {
+			return nil, fmt.Errorf("error creating request: %v", err)
+		}
+		req = req.WithContext(ctx)
+		sdk.dumpRequest(req)
+
+		resp, err := client.Do(req)
+		if err != nil {
+			lastErr = err
+			if attempt == attempts-1 || !sdk.sleepBackoff(ctx, policy.backoff(attempt)) {
+				return nil, lastErr
+			}
+			continue
+		}
+		sdk.dumpResponse(resp)
+
+		body, readErr := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			return nil, fmt.Errorf("error reading response body: %v", readErr)
+		}
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return body, nil
+		}
+
+		httpErr := &HTTPRequestError{StatusCode: resp.StatusCode, Body: string(body), Method: req.Method, URL: req.URL.String()}
+		if !policy.isRetryableStatus(resp.StatusCode) || attempt == attempts-1 {
+			return body, httpErr
+		}
+		wait, ok := parseRetryAfter(resp)
+		if !ok {
+			wait = policy.backoff(attempt)
+		}
+		if !sdk.sleepBackoff(ctx, wait) {
+			return nil, ctx.Err()
+		}
+		lastErr = httpErr
+	}
+	return nil, lastErr
+}
+
+// sleepBackoff waits for d, returning false early if ctx is canceled first.
+func (sdk *SuperAppSDK) sleepBackoff(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
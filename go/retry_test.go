@@ -0,0 +1,91 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// These tests drive doRequest's retry logic through Register, since
+// CallFunction passes idempotent=false and is never retried regardless of
+// RetryPolicy.
+func newRetryTestSDK(baseURL string) *SuperAppSDK {
+	sdk := newSDK("unused", baseURL)
+	sdk.RetryPolicy = RetryPolicy{
+		MaxAttempts:       3,
+		InitialBackoff:    time.Millisecond,
+		MaxBackoff:        5 * time.Millisecond,
+		Multiplier:        2,
+		RetryableStatuses: []int{http.StatusTooManyRequests, 500, 502, 503, 504},
+	}
+	return sdk
+}
+
+func TestRegisterRetries5xxUpToMaxAttempts(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sdk := newRetryTestSDK(server.URL)
+	err := sdk.RegisterContext(context.Background(), "myApp", []string{"greet"}, server.URL)
+	httpErr, ok := err.(*HTTPRequestError)
+	if !ok || httpErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 HTTPRequestError, got %v", err)
+	}
+	if requests != sdk.RetryPolicy.MaxAttempts {
+		t.Errorf("server saw %d requests, want %d (MaxAttempts)", requests, sdk.RetryPolicy.MaxAttempts)
+	}
+}
+
+func TestRegisterDoesNotRetry4xx(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	sdk := newRetryTestSDK(server.URL)
+	err := sdk.RegisterContext(context.Background(), "myApp", []string{"greet"}, server.URL)
+	httpErr, ok := err.(*HTTPRequestError)
+	if !ok || httpErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 HTTPRequestError, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("server saw %d requests, want 1 (no retry on 4xx)", requests)
+	}
+}
+
+func TestRegisterHonorsRetryAfter(t *testing.T) {
+	requests := 0
+	var gotWait time.Duration
+	var last time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			last = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		gotWait = time.Since(last)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	sdk := newRetryTestSDK(server.URL)
+	// InitialBackoff is 1ms, so a measured wait near 1s can only come from
+	// honoring the Retry-After header rather than the computed backoff.
+	if err := sdk.RegisterContext(context.Background(), "myApp", []string{"greet"}, server.URL); err != nil {
+		t.Fatalf("RegisterContext failed: %v", err)
+	}
+	if gotWait < 900*time.Millisecond {
+		t.Errorf("wait before retry = %v, want >= ~1s (Retry-After should override computed backoff)", gotWait)
+	}
+}
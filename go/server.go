@@ -0,0 +1,210 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HandlerFunc implements a single mini-app function invoked through
+// MiniAppServer.
+type HandlerFunc func(ctx context.Context, payload map[string]any) (map[string]any, error)
+
+// MiniAppServer exposes a mini-app's functions over HTTP so the Super App
+// (or other mini-apps, via SuperAppSDK.CallFunction) can invoke them,
+// without every mini-app hand-rolling its own dispatch server.
+type MiniAppServer struct {
+	sdk     *SuperAppSDK
+	appName string
+
+	// HandlerTimeout bounds how long a single handler invocation may run,
+	// via the context passed to it. Zero means no timeout.
+	HandlerTimeout time.Duration
+
+	mu          sync.RWMutex
+	handlers    map[string]HandlerFunc
+	contentType map[string]string
+
+	httpServer *http.Server
+}
+
+// NewMiniAppServer creates a server dispatching to handlers registered with
+// Handle, under the given mini-app name.
+//
+// Handlers are only reachable once the Super App knows the mini-app's URL,
+// so register before serving:
+//
+//	srv := sdk.NewMiniAppServer(appSDK, "myApp")
+//	srv.Handle("greet", greetHandler)
+//	if err := appSDK.RegisterFunctions("myApp", srv.Functions(), "http://myapp:8081"); err != nil {
+//		log.Fatal(err)
+//	}
+//	srv.ListenAndServe(":8081")
+func NewMiniAppServer(sdk *SuperAppSDK, appName string) *MiniAppServer {
+	return &MiniAppServer{
+		sdk:         sdk,
+		appName:     appName,
+		handlers:    make(map[string]HandlerFunc),
+		contentType: make(map[string]string),
+	}
+}
+
+// Handle registers a function reachable at /invoke/{function} that accepts
+// a JSON payload.
+func (s *MiniAppServer) Handle(function string, handler HandlerFunc) {
+	s.HandleWithContentType(function, "application/json", handler)
+}
+
+// HandleMultipart registers a function reachable at /invoke/{function} that
+// accepts a multipart/form-data payload (e.g. file uploads), matching
+// SuperAppSDK.CallFunctionMultipart on the caller side.
+func (s *MiniAppServer) HandleMultipart(function string, handler HandlerFunc) {
+	s.HandleWithContentType(function, "multipart/form-data", handler)
+}
+
+// HandleWithContentType registers a function under an explicit content
+// type, surfaced through Functions() so RegisterFunctions can tell the
+// Super App which functions accept multipart payloads.
+func (s *MiniAppServer) HandleWithContentType(function, contentType string, handler HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[function] = handler
+	s.contentType[function] = contentType
+}
+
+// Functions returns a FunctionSpec per registered handler, in the form
+// SuperAppSDK.RegisterFunctions expects.
+func (s *MiniAppServer) Functions() []FunctionSpec {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	functions := make([]FunctionSpec, 0, len(s.handlers))
+	for name := range s.handlers {
+		functions = append(functions, FunctionSpec{Name: name, ContentType: s.contentType[name]})
+	}
+	return functions
+}
+
+func (s *MiniAppServer) mux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/invoke/", s.handleInvoke)
+	mux.HandleFunc("/isalive", s.handleIsAlive)
+	mux.HandleFunc("/functions", s.handleFunctions)
+	return mux
+}
+
+// ListenAndServe starts the HTTP server on addr, blocking until it stops.
+// Call Shutdown from another goroutine (e.g. on SIGTERM) to stop gracefully.
+func (s *MiniAppServer) ListenAndServe(addr string) error {
+	s.httpServer = &http.Server{Addr: addr, Handler: s.mux()}
+	err := s.httpServer.ListenAndServe()
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+// Shutdown gracefully stops the server, waiting for in-flight handlers to
+// finish or ctx to expire.
+func (s *MiniAppServer) Shutdown(ctx context.Context) error {
+	if s.httpServer == nil {
+		return nil
+	}
+	return s.httpServer.Shutdown(ctx)
+}
+
+// handleIsAlive mirrors the Super App's leader-alive convention: a bare 200
+// means the mini-app is up.
+func (s *MiniAppServer) handleIsAlive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *MiniAppServer) handleFunctions(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{
+		"appName":   s.appName,
+		"functions": s.Functions(),
+	})
+}
+
+func (s *MiniAppServer) handleInvoke(w http.ResponseWriter, r *http.Request) {
+	function := strings.TrimPrefix(r.URL.Path, "/invoke/")
+	if function == "" {
+		http.Error(w, "missing function name", http.StatusBadRequest)
+		return
+	}
+
+	s.mu.RLock()
+	handler, ok := s.handlers[function]
+	contentType := s.contentType[function]
+	s.mu.RUnlock()
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown function %q", function), http.StatusNotFound)
+		return
+	}
+
+	payload, err := decodeInvokePayload(r, contentType)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid payload: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if s.HandlerTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.HandlerTimeout)
+		defer cancel()
+	}
+
+	result, err := handler(ctx, payload)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		s.sdk.Logger.Error("error encoding response", "function", function, "error", err)
+	}
+}
+
+// maxMultipartMemory bounds how much of a multipart request body
+// ParseMultipartForm buffers in memory before spilling to temp files.
+const maxMultipartMemory = 32 << 20 // 32 MiB
+
+// decodeInvokePayload reads an invoke request body into a payload map,
+// decoding it as JSON or multipart/form-data depending on contentType. In a
+// multipart payload each form field maps to its first string value and each
+// file part to its first *multipart.FileHeader.
+func decodeInvokePayload(r *http.Request, contentType string) (map[string]any, error) {
+	if strings.HasPrefix(contentType, "multipart/") {
+		if err := r.ParseMultipartForm(maxMultipartMemory); err != nil {
+			return nil, err
+		}
+		payload := make(map[string]any, len(r.MultipartForm.Value))
+		for name, values := range r.MultipartForm.Value {
+			if len(values) > 0 {
+				payload[name] = values[0]
+			}
+		}
+		for name, files := range r.MultipartForm.File {
+			if len(files) > 0 {
+				payload[name] = files[0]
+			}
+		}
+		return payload, nil
+	}
+
+	if r.Body == nil {
+		return nil, nil
+	}
+	var payload map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return payload, nil
+}
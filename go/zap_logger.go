@@ -0,0 +1,22 @@
+//go:build zap
+
+package sdk
+
+import "go.uber.org/zap"
+
+// ZapLogger adapts a *zap.SugaredLogger to the Logger interface. Build with
+// the "zap" tag (and go.uber.org/zap added to go.mod) to enable it, so the
+// core SDK doesn't force a zap dependency on every consumer.
+type ZapLogger struct {
+	L *zap.SugaredLogger
+}
+
+// NewZapLogger wraps a *zap.SugaredLogger as a Logger.
+func NewZapLogger(l *zap.SugaredLogger) Logger {
+	return &ZapLogger{L: l}
+}
+
+func (z *ZapLogger) Debug(msg string, kv ...any) { z.L.Debugw(msg, kv...) }
+func (z *ZapLogger) Info(msg string, kv ...any)  { z.L.Infow(msg, kv...) }
+func (z *ZapLogger) Warn(msg string, kv ...any)  { z.L.Warnw(msg, kv...) }
+func (z *ZapLogger) Error(msg string, kv ...any) { z.L.Errorw(msg, kv...) }